@@ -0,0 +1,318 @@
+// Package microsandbox is a Go SDK for the Microsandbox sandbox API, with
+// support for sandbox lifecycle management, code and command execution,
+// filesystem access, snapshots/templates, and OpenTelemetry instrumentation.
+package microsandbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultRetryPolicy is used when no WithRetryPolicy option is supplied.
+var defaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    2 * time.Second,
+}
+
+// RetryPolicy controls how the Client retries requests that fail with a
+// transient server error (HTTP 5xx or a network error).
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// A value <= 1 disables retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry. Subsequent retries back
+	// off exponentially, capped at MaxDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff delay between retries.
+	MaxDelay time.Duration
+}
+
+// Client is a Microsandbox API client. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+	retry      RetryPolicy
+
+	logger      *slog.Logger
+	tracer      trace.Tracer
+	instruments *instruments
+
+	prewarmMu    sync.Mutex
+	prewarmPools map[string]*prewarmPool
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient overrides the *http.Client used for requests. This is
+// useful for injecting custom transports, timeouts, or instrumentation.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithRetryPolicy overrides the retry/backoff behavior applied to requests
+// that fail with a transient server error.
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = p
+	}
+}
+
+// NewClient creates a Microsandbox API client for the given server baseURL,
+// authenticating requests with apiKey.
+func NewClient(baseURL, apiKey string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		apiKey:      apiKey,
+		httpClient:  http.DefaultClient,
+		retry:       defaultRetryPolicy,
+		logger:      slog.Default(),
+		tracer:      defaultTracer(),
+		instruments: defaultInstruments(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Sandbox returns a handle to a sandbox with the given id. It does not
+// contact the server; use Create or Status to bring it to a known state.
+func (c *Client) Sandbox(id string) *Sandbox {
+	return &Sandbox{client: c, id: id}
+}
+
+// rpcRequest issues a JSON request to path and decodes the JSON response
+// into out (if non-nil), retrying transient server errors per c.retry.
+// It opens a span and records latency for every call, per the package's
+// telemetry conventions; see span and recordRequestDuration.
+func (c *Client) rpcRequest(ctx context.Context, method, path string, in, out any) error {
+	start := time.Now()
+	ctx, span := c.span(ctx, method, path)
+	defer span.End()
+
+	err := c.doRPC(ctx, span, method, path, in, out)
+
+	c.recordRequestDuration(ctx, method, path, start, err)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return err
+}
+
+func (c *Client) doRPC(ctx context.Context, span trace.Span, method, path string, in, out any) error {
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("microsandbox: encode request: %w", err)
+		}
+	}
+
+	attempts := c.retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := c.retry.BaseDelay
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			span.AddEvent("retry", trace.WithAttributes(attribute.Int("attempt", attempt)))
+			c.logger.DebugContext(ctx, "microsandbox: retrying request", "method", method, "path", path, "attempt", attempt, "cause", lastErr)
+			if err := sleepContext(ctx, delay); err != nil {
+				return err
+			}
+			delay *= 2
+			if c.retry.MaxDelay > 0 && delay > c.retry.MaxDelay {
+				delay = c.retry.MaxDelay
+			}
+		}
+
+		resp, err := c.do(ctx, method, path, body)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		retry, handleErr := handleResponse(resp, out)
+		if handleErr == nil {
+			return nil
+		}
+		lastErr = handleErr
+		if !retry {
+			return lastErr
+		}
+	}
+	return lastErr
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("microsandbox: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("microsandbox: %s %s: %w", method, path, err)
+	}
+	return resp, nil
+}
+
+// doBody issues a request whose body is streamed directly from r rather
+// than buffered into memory first. Since r's length is generally unknown,
+// net/http sends it with chunked transfer encoding.
+func (c *Client) doBody(ctx context.Context, method, path string, r io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, r)
+	if err != nil {
+		return nil, fmt.Errorf("microsandbox: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("microsandbox: %s %s: %w", method, path, err)
+	}
+	return resp, nil
+}
+
+// handleResponse reads and decodes resp into out, translating non-2xx
+// statuses into a typed error. The bool return reports whether the error
+// (if any) is transient and worth retrying.
+func handleResponse(resp *http.Response, out any) (retry bool, err error) {
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, fmt.Errorf("microsandbox: read response: %w", err)
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if out == nil || len(data) == 0 {
+			return false, nil
+		}
+		if err := json.Unmarshal(data, out); err != nil {
+			return false, fmt.Errorf("microsandbox: decode response: %w", err)
+		}
+		return false, nil
+	}
+
+	apiErr := errorFromResponse(resp.StatusCode, data)
+	return resp.StatusCode >= 500, apiErr
+}
+
+// streamEvent is one decoded line of a newline-delimited JSON stream
+// returned by a streaming endpoint (e.g. /run/stream).
+type streamEvent struct {
+	raw json.RawMessage
+	err error
+}
+
+func (e streamEvent) decode(v any) error {
+	if e.err != nil {
+		return e.err
+	}
+	return json.Unmarshal(e.raw, v)
+}
+
+// streamRequest issues a request whose response body is a stream of
+// newline-delimited JSON objects, and returns a channel that delivers one
+// streamEvent per line. The channel is closed when the stream ends or ctx
+// is done.
+func (c *Client) streamRequest(ctx context.Context, method, path string, in any) (<-chan streamEvent, error) {
+	ctx, span := c.span(ctx, method, path)
+
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			span.RecordError(err)
+			span.End()
+			return nil, fmt.Errorf("microsandbox: encode request: %w", err)
+		}
+	}
+
+	resp, err := c.do(ctx, method, path, body)
+	if err != nil {
+		span.RecordError(err)
+		span.End()
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		apiErr := errorFromResponse(resp.StatusCode, data)
+		span.RecordError(apiErr)
+		span.End()
+		return nil, apiErr
+	}
+
+	events := make(chan streamEvent)
+	go func() {
+		defer span.End()
+		defer close(events)
+		defer resp.Body.Close()
+
+		dec := json.NewDecoder(resp.Body)
+		for {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				if err != io.EOF {
+					select {
+					case events <- streamEvent{err: fmt.Errorf("microsandbox: decode stream: %w", err)}:
+					case <-ctx.Done():
+					}
+				}
+				return
+			}
+			select {
+			case events <- streamEvent{raw: raw}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
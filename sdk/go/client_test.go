@@ -0,0 +1,90 @@
+package microsandbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestRetriesTransientServerErrorThenSucceeds(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			http.Error(w, `{"code":"internal","message":"try again"}`, http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id": "sb-1"})
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key", microsandbox.WithRetryPolicy(microsandbox.RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+	}))
+	sb := client.Sandbox("")
+
+	if err := sb.Create(context.Background(), microsandbox.SandboxSpec{Image: "alpine"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("got %d attempts, want 3 (2 failures + 1 success)", got)
+	}
+	if sb.ID() != "sb-1" {
+		t.Errorf("got sandbox id %q, want %q", sb.ID(), "sb-1")
+	}
+}
+
+func TestDoesNotRetryClientError(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, `{"code":"quota_exceeded","message":"too many sandboxes"}`, http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key", microsandbox.WithRetryPolicy(microsandbox.RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+	}))
+	sb := client.Sandbox("")
+
+	err := sb.Create(context.Background(), microsandbox.SandboxSpec{Image: "alpine"})
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("got %d attempts, want 1 (4xx responses are not retried)", got)
+	}
+}
+
+func TestExhaustsRetriesAndReturnsLastError(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, `{"code":"internal","message":"down for maintenance"}`, http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key", microsandbox.WithRetryPolicy(microsandbox.RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+	}))
+	sb := client.Sandbox("")
+
+	err := sb.Create(context.Background(), microsandbox.SandboxSpec{Image: "alpine"})
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("got %d attempts, want 2 (MaxAttempts)", got)
+	}
+}
@@ -0,0 +1,117 @@
+package microsandbox
+
+import (
+	"context"
+	"fmt"
+)
+
+// CommandNamespace exposes process-execution operations scoped to a
+// single sandbox. Obtain one via Sandbox.Command.
+type CommandNamespace struct {
+	sandbox *Sandbox
+}
+
+// Command returns the namespace for running commands inside the sandbox.
+func (s *Sandbox) Command() *CommandNamespace {
+	return &CommandNamespace{sandbox: s}
+}
+
+// CmdOption configures a command execution.
+type CmdOption func(*cmdConfig)
+
+type cmdConfig struct {
+	dir string
+	env map[string]string
+}
+
+// WithDir sets the working directory the command runs in, relative to the
+// sandbox's default working directory.
+func WithDir(dir string) CmdOption {
+	return func(c *cmdConfig) { c.dir = dir }
+}
+
+// WithEnv adds environment variables visible to the command, layered on
+// top of the sandbox's own Env.
+func WithEnv(env map[string]string) CmdOption {
+	return func(c *cmdConfig) { c.env = env }
+}
+
+func buildCmdConfig(opts []CmdOption) cmdConfig {
+	var cfg cmdConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+type commandRequest struct {
+	Argv []string          `json:"argv"`
+	Dir  string            `json:"dir,omitempty"`
+	Env  map[string]string `json:"env,omitempty"`
+}
+
+// Run executes argv inside the guest and waits for it to exit.
+func (c *CommandNamespace) Run(ctx context.Context, argv []string, opts ...CmdOption) (*Execution, error) {
+	cfg := buildCmdConfig(opts)
+	var resp runResponse
+	req := commandRequest{Argv: argv, Dir: cfg.dir, Env: cfg.env}
+	if err := c.sandbox.client.rpcRequest(c.sandbox.traceCtx(ctx), "POST", c.sandbox.path("/command/run"), req, &resp); err != nil {
+		return nil, fmt.Errorf("microsandbox: run command %v: %w", argv, err)
+	}
+	return &Execution{stdout: resp.Stdout, stderr: resp.Stderr, exitCode: resp.ExitCode}, nil
+}
+
+// Process is a handle to a detached, still-running process started with
+// CommandNamespace.Start.
+type Process struct {
+	sandbox *Sandbox
+	id      string
+}
+
+type startCommandResponse struct {
+	ID string `json:"id"`
+}
+
+// Start launches argv inside the guest without waiting for it to exit.
+// Use the returned Process to Wait, Signal, or Kill it.
+func (c *CommandNamespace) Start(ctx context.Context, argv []string, opts ...CmdOption) (*Process, error) {
+	cfg := buildCmdConfig(opts)
+	var resp startCommandResponse
+	req := commandRequest{Argv: argv, Dir: cfg.dir, Env: cfg.env}
+	if err := c.sandbox.client.rpcRequest(c.sandbox.traceCtx(ctx), "POST", c.sandbox.path("/command/start"), req, &resp); err != nil {
+		return nil, fmt.Errorf("microsandbox: start command %v: %w", argv, err)
+	}
+	return &Process{sandbox: c.sandbox, id: resp.ID}, nil
+}
+
+// Wait blocks until the process exits and returns its result.
+func (p *Process) Wait(ctx context.Context) (*Execution, error) {
+	var resp runResponse
+	if err := p.sandbox.client.rpcRequest(p.sandbox.traceCtx(ctx), "GET", p.path("/wait"), nil, &resp); err != nil {
+		return nil, fmt.Errorf("microsandbox: wait for process %s: %w", p.id, err)
+	}
+	return &Execution{stdout: resp.Stdout, stderr: resp.Stderr, exitCode: resp.ExitCode}, nil
+}
+
+// Signal sends a POSIX signal (e.g. "SIGTERM") to the process.
+func (p *Process) Signal(ctx context.Context, signal string) error {
+	req := struct {
+		Signal string `json:"signal"`
+	}{Signal: signal}
+	if err := p.sandbox.client.rpcRequest(p.sandbox.traceCtx(ctx), "POST", p.path("/signal"), req, nil); err != nil {
+		return fmt.Errorf("microsandbox: signal process %s: %w", p.id, err)
+	}
+	return nil
+}
+
+// Kill forcibly terminates the process (SIGKILL).
+func (p *Process) Kill(ctx context.Context) error {
+	if err := p.sandbox.client.rpcRequest(p.sandbox.traceCtx(ctx), "POST", p.path("/kill"), nil, nil); err != nil {
+		return fmt.Errorf("microsandbox: kill process %s: %w", p.id, err)
+	}
+	return nil
+}
+
+func (p *Process) path(suffix string) string {
+	return p.sandbox.path("/command/" + p.id + suffix)
+}
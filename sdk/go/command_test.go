@@ -0,0 +1,95 @@
+package microsandbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestCommandRunReturnsExecutionResult(t *testing.T) {
+	var gotReq map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sandboxes/sb-1/command/run" {
+			http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&gotReq)
+		json.NewEncoder(w).Encode(map[string]any{"stdout": "hi\n", "stderr": "", "exitCode": 0})
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	exec, err := sb.Command().Run(context.Background(), []string{"echo", "hi"}, microsandbox.WithDir("/tmp"))
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if exec.Stdout() != "hi\n" {
+		t.Errorf("got stdout %q, want %q", exec.Stdout(), "hi\n")
+	}
+	if exec.HasError() {
+		t.Error("HasError() = true, want false")
+	}
+	if gotReq["dir"] != "/tmp" {
+		t.Errorf("got dir %v, want /tmp", gotReq["dir"])
+	}
+}
+
+func TestCommandStartWaitSignalKill(t *testing.T) {
+	var signaled, killed []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes/sb-1/command/start":
+			json.NewEncoder(w).Encode(map[string]string{"id": "proc-1"})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/sandboxes/sb-1/command/proc-1/wait":
+			json.NewEncoder(w).Encode(map[string]any{"stdout": "done", "stderr": "", "exitCode": 7})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes/sb-1/command/proc-1/signal":
+			var body struct {
+				Signal string `json:"signal"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			signaled = append(signaled, body.Signal)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes/sb-1/command/proc-1/kill":
+			killed = append(killed, "proc-1")
+		default:
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+	ctx := context.Background()
+
+	proc, err := sb.Command().Start(ctx, []string{"sleep", "1"})
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	if err := proc.Signal(ctx, "SIGTERM"); err != nil {
+		t.Fatalf("Signal: %v", err)
+	}
+	if len(signaled) != 1 || signaled[0] != "SIGTERM" {
+		t.Errorf("got signaled %v, want [SIGTERM]", signaled)
+	}
+
+	exec, err := proc.Wait(ctx)
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if exec.ExitCode() != 7 {
+		t.Errorf("got exit code %d, want 7", exec.ExitCode())
+	}
+
+	if err := proc.Kill(ctx); err != nil {
+		t.Fatalf("Kill: %v", err)
+	}
+	if len(killed) != 1 {
+		t.Errorf("got %d kills, want 1", len(killed))
+	}
+}
@@ -0,0 +1,92 @@
+package microsandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by Client and Sandbox methods. Use errors.Is to
+// test for them, since they may be wrapped with additional context.
+var (
+	// ErrSandboxNotFound is returned when the referenced sandbox does not
+	// exist or has already been destroyed.
+	ErrSandboxNotFound = errors.New("microsandbox: sandbox not found")
+
+	// ErrQuotaExceeded is returned when the account or organization has hit
+	// a resource quota (e.g. concurrent sandboxes, CPU, memory).
+	ErrQuotaExceeded = errors.New("microsandbox: quota exceeded")
+
+	// ErrTemplateNotFound is returned when a referenced template does not
+	// exist.
+	ErrTemplateNotFound = errors.New("microsandbox: template not found")
+
+	// ErrUnauthorized is returned when the API key is missing or invalid.
+	ErrUnauthorized = errors.New("microsandbox: unauthorized")
+)
+
+// APIError represents a structured error response from the Microsandbox
+// server. It wraps one of the sentinel errors above (when the server
+// reports a recognized code or, failing that, a 401, 403 or 404 status) so
+// callers can branch with errors.Is while still seeing the server-provided
+// message via Error().
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the server's machine-readable error code, e.g. "sandbox_not_found".
+	Code string
+	// Message is the server's human-readable error message.
+	Message string
+
+	wrapped error
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("microsandbox: %s (%s, status %d)", e.Message, e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("microsandbox: %s (status %d)", e.Message, e.StatusCode)
+}
+
+// Unwrap allows errors.Is(err, ErrSandboxNotFound) and similar checks to
+// succeed against the sentinel the server code or status mapped to, if any.
+func (e *APIError) Unwrap() error {
+	return e.wrapped
+}
+
+var codeToSentinel = map[string]error{
+	"sandbox_not_found":  ErrSandboxNotFound,
+	"quota_exceeded":     ErrQuotaExceeded,
+	"template_not_found": ErrTemplateNotFound,
+	"unauthorized":       ErrUnauthorized,
+}
+
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// errorFromResponse builds an *APIError from a non-2xx HTTP response body,
+// falling back to the raw body text if it isn't in the expected shape.
+func errorFromResponse(statusCode int, body []byte) *APIError {
+	var eb errorBody
+	if err := json.Unmarshal(body, &eb); err != nil || eb.Message == "" {
+		eb.Message = string(body)
+	}
+
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Code:       eb.Code,
+		Message:    eb.Message,
+	}
+
+	if sentinel, ok := codeToSentinel[eb.Code]; ok {
+		apiErr.wrapped = sentinel
+	} else if statusCode == 401 || statusCode == 403 {
+		apiErr.wrapped = ErrUnauthorized
+	} else if statusCode == 404 {
+		apiErr.wrapped = ErrSandboxNotFound
+	}
+
+	return apiErr
+}
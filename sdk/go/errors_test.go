@@ -0,0 +1,55 @@
+package microsandbox_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestAPIErrorUnwrapsToSentinel(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       error
+	}{
+		{"sandbox not found by code", http.StatusNotFound, `{"code":"sandbox_not_found","message":"no such sandbox"}`, microsandbox.ErrSandboxNotFound},
+		{"sandbox not found by status", http.StatusNotFound, `{"message":"gone"}`, microsandbox.ErrSandboxNotFound},
+		{"quota exceeded", http.StatusTooManyRequests, `{"code":"quota_exceeded","message":"too many sandboxes"}`, microsandbox.ErrQuotaExceeded},
+		{"template not found", http.StatusNotFound, `{"code":"template_not_found","message":"no such template"}`, microsandbox.ErrTemplateNotFound},
+		{"unauthorized by code", http.StatusForbidden, `{"code":"unauthorized","message":"nope"}`, microsandbox.ErrUnauthorized},
+		{"unauthorized by status", http.StatusUnauthorized, `{"message":"bad key"}`, microsandbox.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, tt.body, tt.statusCode)
+			}))
+			defer srv.Close()
+
+			client := microsandbox.NewClient(srv.URL, "test-key", microsandbox.WithRetryPolicy(microsandbox.RetryPolicy{MaxAttempts: 1}))
+			sb := client.Sandbox("")
+
+			err := sb.Create(context.Background(), microsandbox.SandboxSpec{Image: "alpine"})
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.want)
+			}
+
+			var apiErr *microsandbox.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("errors.As into *APIError failed for %v", err)
+			}
+			if apiErr.StatusCode != tt.statusCode {
+				t.Errorf("got StatusCode %d, want %d", apiErr.StatusCode, tt.statusCode)
+			}
+		})
+	}
+}
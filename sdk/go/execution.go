@@ -0,0 +1,162 @@
+package microsandbox
+
+import (
+	"context"
+	"fmt"
+)
+
+// Language identifies an interpreter available inside a sandbox.
+type Language string
+
+const (
+	LanguagePython Language = "python"
+	LanguageNode   Language = "node"
+)
+
+// Execution is the result of a completed Sandbox.Run call.
+type Execution struct {
+	stdout   string
+	stderr   string
+	exitCode int
+}
+
+// Stdout returns everything the execution wrote to standard output.
+func (e *Execution) Stdout() string {
+	return e.stdout
+}
+
+// Stderr returns everything the execution wrote to standard error.
+func (e *Execution) Stderr() string {
+	return e.stderr
+}
+
+// ExitCode returns the process exit code. For interpreters that don't
+// exit a process per call (e.g. a REPL eval), this is 0 unless the
+// interpreter itself reported a failure.
+func (e *Execution) ExitCode() int {
+	return e.exitCode
+}
+
+// HasError reports whether the execution failed, either because it exited
+// non-zero or wrote to stderr.
+func (e *Execution) HasError() bool {
+	return e.exitCode != 0 || e.stderr != ""
+}
+
+type runRequest struct {
+	Language Language `json:"language"`
+	Code     string   `json:"code"`
+}
+
+type runResponse struct {
+	Stdout   string `json:"stdout"`
+	Stderr   string `json:"stderr"`
+	ExitCode int    `json:"exitCode"`
+}
+
+// Run executes code with the given language's interpreter inside the
+// sandbox and waits for it to complete.
+func (s *Sandbox) Run(ctx context.Context, language Language, code string) (*Execution, error) {
+	var resp runResponse
+	req := runRequest{Language: language, Code: code}
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "POST", s.path("/run"), req, &resp); err != nil {
+		return nil, fmt.Errorf("microsandbox: run %s code: %w", language, err)
+	}
+	return &Execution{stdout: resp.Stdout, stderr: resp.Stderr, exitCode: resp.ExitCode}, nil
+}
+
+// OutputChunk is a piece of output streamed from a running execution,
+// tagged with the stream it came from.
+type OutputChunk struct {
+	Stream string // "stdout" or "stderr"
+	Data   string
+}
+
+// StreamedExecution is the handle returned by RunStream for an
+// in-progress execution.
+type StreamedExecution struct {
+	// Chunks delivers stdout/stderr output as it is produced. It is closed
+	// once the execution completes or ctx is done.
+	Chunks <-chan OutputChunk
+
+	// Done receives nil once the execution completes (the exit code is then
+	// available from ExitCode), or a non-nil error if it could not be
+	// determined (e.g. ctx cancellation). Its value is sent before Chunks is
+	// closed, so it can be read without blocking once Chunks is drained.
+	Done <-chan error
+
+	exitCode *int
+}
+
+// ExitCode returns the execution's exit code once Done has resolved
+// without error. It returns 0 beforehand.
+func (se *StreamedExecution) ExitCode() int {
+	if se.exitCode == nil {
+		return 0
+	}
+	return *se.exitCode
+}
+
+// RunStream is like Run but streams stdout/stderr as they are produced
+// instead of buffering the whole output, which matters for long-running
+// scripts.
+func (s *Sandbox) RunStream(ctx context.Context, language Language, code string) (*StreamedExecution, error) {
+	events, err := s.client.streamRequest(s.traceCtx(ctx), "POST", s.path("/run/stream"), runRequest{Language: language, Code: code})
+	if err != nil {
+		return nil, fmt.Errorf("microsandbox: stream %s code: %w", language, err)
+	}
+
+	chunks := make(chan OutputChunk)
+	done := make(chan error, 1)
+	se := &StreamedExecution{Chunks: chunks, Done: done}
+
+	go func() {
+		defer close(chunks)
+		defer close(done)
+
+		for {
+			select {
+			case <-ctx.Done():
+				done <- ctx.Err()
+				return
+			case ev, ok := <-events:
+				if !ok {
+					done <- nil
+					return
+				}
+				if ev.err != nil {
+					done <- ev.err
+					return
+				}
+				var chunk streamChunk
+				if err := ev.decode(&chunk); err != nil {
+					done <- err
+					return
+				}
+				if chunk.Done {
+					code := chunk.ExitCode
+					se.exitCode = &code
+					done <- nil
+					return
+				}
+				select {
+				case chunks <- OutputChunk{Stream: chunk.Stream, Data: chunk.Data}:
+				case <-ctx.Done():
+					done <- ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return se, nil
+}
+
+// streamChunk is the wire shape of one server-sent event in a /run/stream
+// response.
+type streamChunk struct {
+	Stream   string `json:"stream,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Done     bool   `json:"done,omitempty"`
+	ExitCode int    `json:"exitCode,omitempty"`
+}
@@ -0,0 +1,90 @@
+package microsandbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestRunStreamDeliversChunksInOrder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		enc := json.NewEncoder(w)
+		for _, chunk := range []map[string]any{
+			{"stream": "stdout", "data": "hello "},
+			{"stream": "stdout", "data": "world"},
+			{"done": true, "exitCode": 0},
+		} {
+			if err := enc.Encode(chunk); err != nil {
+				t.Errorf("encode chunk: %v", err)
+				return
+			}
+			flusher.Flush()
+		}
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	se, err := sb.RunStream(context.Background(), microsandbox.LanguagePython, "print('hi')")
+	if err != nil {
+		t.Fatalf("RunStream: %v", err)
+	}
+
+	var got string
+	for chunk := range se.Chunks {
+		got += chunk.Data
+	}
+	if err := <-se.Done; err != nil {
+		t.Fatalf("Done: %v", err)
+	}
+	if got != "hello world" {
+		t.Errorf("got stdout %q, want %q", got, "hello world")
+	}
+	if se.ExitCode() != 0 {
+		t.Errorf("got exit code %d, want 0", se.ExitCode())
+	}
+}
+
+// TestRunStreamContextCancellation verifies that canceling the caller's
+// context while a stream is open unblocks Done promptly, instead of
+// hanging until the server closes the connection.
+func TestRunStreamContextCancellation(t *testing.T) {
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-block // hold the connection open until the test closes it
+	}))
+	defer srv.Close()
+	defer close(block)
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	se, err := sb.RunStream(ctx, microsandbox.LanguagePython, "while True: pass")
+	if err != nil {
+		t.Fatalf("RunStream: %v", err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	select {
+	case err := <-se.Done:
+		if err == nil {
+			t.Error("expected an error from Done after context cancellation, got nil")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Done did not resolve promptly after context cancellation")
+	}
+}
@@ -0,0 +1,231 @@
+package microsandbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FSNamespace exposes filesystem operations scoped to the guest filesystem
+// of a single sandbox. Obtain one via Sandbox.FS.
+type FSNamespace struct {
+	sandbox *Sandbox
+}
+
+// FS returns the namespace for filesystem operations inside the sandbox.
+func (s *Sandbox) FS() *FSNamespace {
+	return &FSNamespace{sandbox: s}
+}
+
+// FileInfo describes a file or directory in the guest filesystem.
+type FileInfo struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	IsDir   bool      `json:"isDir"`
+	Mode    uint32    `json:"mode"`
+	ModTime time.Time `json:"modTime"`
+}
+
+type fsPathRequest struct {
+	Path string `json:"path"`
+}
+
+// ReadFile reads the entire contents of path. For large files, prefer
+// Download, which streams instead of buffering.
+func (f *FSNamespace) ReadFile(ctx context.Context, path string) ([]byte, error) {
+	rc, err := f.Download(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("microsandbox: read file %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// WriteFile writes data to path, replacing it if it already exists. For
+// large payloads, prefer Upload, which streams instead of buffering.
+func (f *FSNamespace) WriteFile(ctx context.Context, path string, data []byte) error {
+	return f.Upload(ctx, path, bytes.NewReader(data))
+}
+
+// Mkdir creates path, including any missing parent directories.
+func (f *FSNamespace) Mkdir(ctx context.Context, path string) error {
+	req := fsPathRequest{Path: path}
+	if err := f.sandbox.client.rpcRequest(f.sandbox.traceCtx(ctx), "POST", f.sandbox.path("/fs/mkdir"), req, nil); err != nil {
+		return fmt.Errorf("microsandbox: mkdir %s: %w", path, err)
+	}
+	return nil
+}
+
+// Remove deletes path. If path is a non-empty directory, it is removed
+// recursively.
+func (f *FSNamespace) Remove(ctx context.Context, path string) error {
+	req := fsPathRequest{Path: path}
+	if err := f.sandbox.client.rpcRequest(f.sandbox.traceCtx(ctx), "POST", f.sandbox.path("/fs/remove"), req, nil); err != nil {
+		return fmt.Errorf("microsandbox: remove %s: %w", path, err)
+	}
+	return nil
+}
+
+// Stat returns metadata about path.
+func (f *FSNamespace) Stat(ctx context.Context, path string) (*FileInfo, error) {
+	var info FileInfo
+	req := fsPathRequest{Path: path}
+	if err := f.sandbox.client.rpcRequest(f.sandbox.traceCtx(ctx), "POST", f.sandbox.path("/fs/stat"), req, &info); err != nil {
+		return nil, fmt.Errorf("microsandbox: stat %s: %w", path, err)
+	}
+	return &info, nil
+}
+
+// List returns the entries directly inside directory path.
+func (f *FSNamespace) List(ctx context.Context, path string) ([]FileInfo, error) {
+	var entries []FileInfo
+	req := fsPathRequest{Path: path}
+	if err := f.sandbox.client.rpcRequest(f.sandbox.traceCtx(ctx), "POST", f.sandbox.path("/fs/list"), req, &entries); err != nil {
+		return nil, fmt.Errorf("microsandbox: list %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// WalkFunc is called for each entry visited by Walk. Returning an error
+// stops the walk and the error propagates out of Walk.
+type WalkFunc func(info FileInfo) error
+
+// Walk recursively visits every file and directory under root, calling fn
+// for each one in the order reported by the server.
+func (f *FSNamespace) Walk(ctx context.Context, root string, fn WalkFunc) error {
+	var entries []FileInfo
+	req := fsPathRequest{Path: root}
+	if err := f.sandbox.client.rpcRequest(f.sandbox.traceCtx(ctx), "POST", f.sandbox.path("/fs/walk"), req, &entries); err != nil {
+		return fmt.Errorf("microsandbox: walk %s: %w", root, err)
+	}
+	for _, info := range entries {
+		if err := fn(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Download streams the contents of path from the guest filesystem. The
+// returned ReadCloser implements io.Reader and should be closed by the
+// caller once done, e.g. to pipe directly into a tar extractor without
+// buffering the whole file in memory.
+func (f *FSNamespace) Download(ctx context.Context, path string) (io.ReadCloser, error) {
+	ctx = f.sandbox.traceCtx(ctx)
+	start := time.Now()
+	reqPath := f.sandbox.path("/fs/download?path=" + url.QueryEscape(path))
+	ctx, span := f.sandbox.client.span(ctx, "GET", reqPath)
+
+	resp, err := f.sandbox.client.do(ctx, "GET", reqPath, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.End()
+		f.sandbox.client.recordRequestDuration(ctx, "GET", reqPath, start, err)
+		return nil, fmt.Errorf("microsandbox: download %s: %w", path, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		apiErr := errorFromResponse(resp.StatusCode, data)
+		span.RecordError(apiErr)
+		span.End()
+		f.sandbox.client.recordRequestDuration(ctx, "GET", reqPath, start, apiErr)
+		return nil, apiErr
+	}
+
+	return &countingReadCloser{
+		ctx:     ctx,
+		rc:      resp.Body,
+		counter: f.sandbox.client.instruments.bytesTransferred,
+		span:    span,
+		onClose: func(closeErr error) {
+			f.sandbox.client.recordRequestDuration(ctx, "GET", reqPath, start, closeErr)
+		},
+	}, nil
+}
+
+// Upload streams r's contents to path in the guest filesystem using
+// chunked transfer, so large payloads never need to be buffered into
+// memory up front.
+func (f *FSNamespace) Upload(ctx context.Context, path string, r io.Reader) error {
+	ctx = f.sandbox.traceCtx(ctx)
+	start := time.Now()
+	reqPath := f.sandbox.path("/fs/upload?path=" + url.QueryEscape(path))
+	ctx, span := f.sandbox.client.span(ctx, "PUT", reqPath)
+	defer span.End()
+
+	counted := &countingReader{ctx: ctx, r: r, counter: f.sandbox.client.instruments.bytesTransferred}
+	resp, err := f.sandbox.client.doBody(ctx, "PUT", reqPath, counted)
+	if err != nil {
+		span.RecordError(err)
+		f.sandbox.client.recordRequestDuration(ctx, "PUT", reqPath, start, err)
+		return fmt.Errorf("microsandbox: upload %s: %w", path, err)
+	}
+	if _, err := handleResponse(resp, nil); err != nil {
+		span.RecordError(err)
+		f.sandbox.client.recordRequestDuration(ctx, "PUT", reqPath, start, err)
+		return fmt.Errorf("microsandbox: upload %s: %w", path, err)
+	}
+	f.sandbox.client.recordRequestDuration(ctx, "PUT", reqPath, start, nil)
+	return nil
+}
+
+// countingReader wraps an io.Reader, recording each Read's byte count to
+// the bytesTransferred instrument.
+type countingReader struct {
+	ctx     context.Context
+	r       io.Reader
+	counter metric.Int64Counter
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if n > 0 {
+		c.counter.Add(c.ctx, int64(n), metric.WithAttributes(attribute.String("direction", "upload")))
+	}
+	return n, err
+}
+
+// countingReadCloser is the ReadCloser counterpart of countingReader, used
+// for downloads. Since the download span stays open for as long as the
+// caller is still reading, it is ended (and the request's latency
+// recorded) in Close rather than by the caller that issued the request.
+type countingReadCloser struct {
+	ctx     context.Context
+	rc      io.ReadCloser
+	counter metric.Int64Counter
+	span    trace.Span
+	onClose func(err error)
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.rc.Read(p)
+	if n > 0 {
+		c.counter.Add(c.ctx, int64(n), metric.WithAttributes(attribute.String("direction", "download")))
+	}
+	return n, err
+}
+
+func (c *countingReadCloser) Close() error {
+	err := c.rc.Close()
+	if err != nil {
+		c.span.RecordError(err)
+	}
+	c.span.End()
+	if c.onClose != nil {
+		c.onClose(err)
+	}
+	return err
+}
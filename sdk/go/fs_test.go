@@ -0,0 +1,134 @@
+package microsandbox_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+// stepReader yields one chunk per Read call, blocking before each chunk
+// after the first until proceed is closed. It lets a test observe whether
+// a caller consumed the reader incrementally rather than all at once.
+type stepReader struct {
+	chunks  [][]byte
+	i       int
+	proceed chan struct{}
+}
+
+func (r *stepReader) Read(p []byte) (int, error) {
+	if r.i >= len(r.chunks) {
+		return 0, io.EOF
+	}
+	if r.i > 0 {
+		<-r.proceed
+	}
+	n := copy(p, r.chunks[r.i])
+	r.i++
+	return n, nil
+}
+
+func TestUploadStreamsRatherThanBuffering(t *testing.T) {
+	received := make(chan int, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf := make([]byte, 4)
+		for {
+			n, err := r.Body.Read(buf)
+			if n > 0 {
+				received <- n
+			}
+			if err != nil {
+				break
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	fs := client.Sandbox("sb-1").FS()
+	reader := &stepReader{chunks: [][]byte{[]byte("AAAA"), []byte("BBBB")}, proceed: make(chan struct{})}
+
+	done := make(chan error, 1)
+	go func() { done <- fs.Upload(context.Background(), "/tmp/f", reader) }()
+
+	select {
+	case n := <-received:
+		if n != 4 {
+			t.Fatalf("got %d bytes in first chunk, want 4", n)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("server never received the first chunk")
+	}
+
+	select {
+	case <-received:
+		t.Fatal("server received the second chunk before the reader produced it — Upload is buffering instead of streaming")
+	default:
+	}
+
+	close(reader.proceed)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Upload: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Upload did not complete after the reader was unblocked")
+	}
+}
+
+func TestDownloadStreamsRatherThanBuffering(t *testing.T) {
+	proceed := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		w.Write([]byte("AAAA"))
+		flusher.Flush()
+		<-proceed
+		w.Write([]byte("BBBB"))
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	fs := client.Sandbox("sb-1").FS()
+
+	rc, err := fs.Download(context.Background(), "/tmp/f")
+	if err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+	defer rc.Close()
+
+	first := make([]byte, 4)
+	readDone := make(chan error, 1)
+	go func() {
+		_, err := io.ReadFull(rc, first)
+		readDone <- err
+	}()
+
+	select {
+	case err := <-readDone:
+		if err != nil {
+			t.Fatalf("reading first chunk: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Download did not deliver the first chunk before the server produced the second")
+	}
+	if string(first) != "AAAA" {
+		t.Errorf("got first chunk %q, want %q", first, "AAAA")
+	}
+
+	close(proceed)
+
+	second := make([]byte, 4)
+	if _, err := io.ReadFull(rc, second); err != nil {
+		t.Fatalf("reading second chunk: %v", err)
+	}
+	if string(second) != "BBBB" {
+		t.Errorf("got second chunk %q, want %q", second, "BBBB")
+	}
+}
@@ -0,0 +1,126 @@
+package microsandbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// prewarmPool holds paused sandboxes booted from a single template, ready
+// to be handed out on Sandbox.Create for a sub-second start.
+type prewarmPool struct {
+	mu        sync.Mutex
+	template  string
+	available []string // sandbox IDs, paused and waiting
+}
+
+// Prewarm brings the pool of paused sandboxes for template up to n,
+// booting as many new ones as needed. Call it once at startup and again
+// whenever steady-state throughput increases; Sandbox.Create drains the
+// pool automatically whenever SandboxSpec.FromTemplate matches.
+func (c *Client) Prewarm(ctx context.Context, template string, n int) error {
+	pool := c.poolFor(template)
+
+	pool.mu.Lock()
+	missing := n - len(pool.available)
+	pool.mu.Unlock()
+
+	for i := 0; i < missing; i++ {
+		sb := c.Sandbox("")
+		// createRemote, not Create: Create would try to drain this same
+		// pool, self-consuming sandboxes this very call is meant to add.
+		if err := sb.createRemote(ctx, SandboxSpec{FromTemplate: template}); err != nil {
+			return fmt.Errorf("microsandbox: prewarm %s: %w", template, err)
+		}
+		if err := sb.Start(ctx); err != nil {
+			werr := fmt.Errorf("microsandbox: prewarm %s: %w", template, err)
+			c.destroyOrphaned(ctx, sb, werr)
+			return werr
+		}
+		if err := sb.Stop(ctx); err != nil {
+			werr := fmt.Errorf("microsandbox: prewarm %s: %w", template, err)
+			c.destroyOrphaned(ctx, sb, werr)
+			return werr
+		}
+		// Parked in the pool, not yet handed to a caller: not in-flight
+		// until checkoutPrewarmed resumes it on a future Create.
+		c.instruments.inFlightSandboxes.Add(ctx, -1)
+
+		pool.mu.Lock()
+		pool.available = append(pool.available, sb.id)
+		pool.mu.Unlock()
+	}
+	return nil
+}
+
+func (c *Client) poolFor(template string) *prewarmPool {
+	c.prewarmMu.Lock()
+	defer c.prewarmMu.Unlock()
+
+	if c.prewarmPools == nil {
+		c.prewarmPools = make(map[string]*prewarmPool)
+	}
+	pool, ok := c.prewarmPools[template]
+	if !ok {
+		pool = &prewarmPool{template: template}
+		c.prewarmPools[template] = pool
+	}
+	return pool
+}
+
+// take removes and returns a paused sandbox ID from the pool, if any are
+// available.
+func (p *prewarmPool) take() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.available) == 0 {
+		return "", false
+	}
+	id := p.available[len(p.available)-1]
+	p.available = p.available[:len(p.available)-1]
+	return id, true
+}
+
+// checkoutPrewarmed hands out a paused sandbox for template, resuming it,
+// if one is available in the pool. It reports false if the pool is empty
+// or no pool has been established for template.
+func (c *Client) checkoutPrewarmed(ctx context.Context, template string) (string, bool, error) {
+	c.prewarmMu.Lock()
+	pool, ok := c.prewarmPools[template]
+	c.prewarmMu.Unlock()
+	if !ok {
+		return "", false, nil
+	}
+
+	id, ok := pool.take()
+	if !ok {
+		return "", false, nil
+	}
+
+	sb := &Sandbox{client: c, id: id}
+	if err := sb.Start(ctx); err != nil {
+		werr := fmt.Errorf("microsandbox: resume prewarmed sandbox %s: %w", id, err)
+		// pool.take already removed id from the pool, so this sandbox has
+		// no remaining reference anywhere once we return; destroy it on
+		// the server rather than leaking it. It was parked (not counted
+		// as in-flight), so destroyRemote -- not Destroy -- to avoid
+		// double-decrementing the in-flight counter.
+		if destroyErr := sb.destroyRemote(ctx); destroyErr != nil {
+			c.logger.ErrorContext(ctx, "microsandbox: failed to destroy orphaned prewarmed sandbox", "sandbox_id", id, "cause", werr, "destroy_error", destroyErr)
+		}
+		return "", false, werr
+	}
+	return id, true, nil
+}
+
+// destroyOrphaned destroys a sandbox that Prewarm's fill loop booted and
+// counted as in-flight, but which failed before it could be parked in the
+// pool -- so it isn't both leaked on the server and stuck counted as
+// in-flight forever. Best effort: a destroy failure here is logged, not
+// returned, so it doesn't mask the original error that triggered it.
+func (c *Client) destroyOrphaned(ctx context.Context, sb *Sandbox, cause error) {
+	if err := sb.Destroy(ctx); err != nil {
+		c.logger.ErrorContext(ctx, "microsandbox: failed to destroy orphaned sandbox after prewarm error", "sandbox_id", sb.id, "cause", cause, "destroy_error", err)
+	}
+}
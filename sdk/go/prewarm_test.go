@@ -0,0 +1,192 @@
+package microsandbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+// fakeSandboxServer is a minimal stand-in for the sandbox lifecycle
+// endpoints, just enough to exercise Prewarm and checkout.
+type fakeSandboxServer struct {
+	mu           sync.Mutex
+	createCount  int
+	starts       map[string]int
+	stops        map[string]int
+	destroys     map[string]int
+	failStartFor map[string]bool
+}
+
+func newFakeSandboxServer() *fakeSandboxServer {
+	return &fakeSandboxServer{
+		starts:       map[string]int{},
+		stops:        map[string]int{},
+		destroys:     map[string]int{},
+		failStartFor: map[string]bool{},
+	}
+}
+
+func (f *fakeSandboxServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes":
+		f.createCount++
+		id := fmt.Sprintf("sb-%d", f.createCount)
+		json.NewEncoder(w).Encode(map[string]string{"id": id})
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/start"):
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/sandboxes/"), "/start")
+		if f.failStartFor[id] {
+			http.Error(w, `{"code":"internal","message":"boot failed"}`, http.StatusInternalServerError)
+			return
+		}
+		f.starts[id]++
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/stop"):
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/sandboxes/"), "/stop")
+		f.stops[id]++
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/sandboxes/"):
+		id := strings.TrimPrefix(r.URL.Path, "/v1/sandboxes/")
+		f.destroys[id]++
+	default:
+		http.Error(w, "unexpected path "+r.URL.Path, http.StatusNotFound)
+	}
+}
+
+func TestPrewarmFillsPoolWithoutExtraCreates(t *testing.T) {
+	fake := newFakeSandboxServer()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	ctx := context.Background()
+
+	if err := client.Prewarm(ctx, "py-template", 2); err != nil {
+		t.Fatalf("Prewarm: %v", err)
+	}
+
+	fake.mu.Lock()
+	createsAfterPrewarm := fake.createCount
+	fake.mu.Unlock()
+	if createsAfterPrewarm != 2 {
+		t.Fatalf("got %d creates after Prewarm(n=2), want 2", createsAfterPrewarm)
+	}
+
+	sb := client.Sandbox("")
+	if err := sb.Create(ctx, microsandbox.SandboxSpec{FromTemplate: "py-template"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if sb.ID() == "" {
+		t.Fatal("Create did not assign a sandbox id from the pool")
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.createCount != createsAfterPrewarm {
+		t.Errorf("Create from a prewarmed template hit /v1/sandboxes (createCount went from %d to %d); want it to drain the pool instead", createsAfterPrewarm, fake.createCount)
+	}
+	if fake.starts[sb.ID()] < 2 {
+		t.Errorf("got %d starts for checked-out sandbox %s, want at least 2 (one from Prewarm, one from checkout)", fake.starts[sb.ID()], sb.ID())
+	}
+}
+
+func TestPrewarmExhaustedPoolFallsBackToCreate(t *testing.T) {
+	fake := newFakeSandboxServer()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	ctx := context.Background()
+
+	if err := client.Prewarm(ctx, "py-template", 1); err != nil {
+		t.Fatalf("Prewarm: %v", err)
+	}
+
+	first := client.Sandbox("")
+	if err := first.Create(ctx, microsandbox.SandboxSpec{FromTemplate: "py-template"}); err != nil {
+		t.Fatalf("Create (pooled): %v", err)
+	}
+
+	second := client.Sandbox("")
+	if err := second.Create(ctx, microsandbox.SandboxSpec{FromTemplate: "py-template"}); err != nil {
+		t.Fatalf("Create (pool exhausted): %v", err)
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.createCount != 2 {
+		t.Errorf("got %d creates after pool exhaustion, want 2 (1 prewarmed + 1 fresh boot)", fake.createCount)
+	}
+	if first.ID() == second.ID() {
+		t.Errorf("pooled and freshly-booted sandboxes should not share an id, both got %s", first.ID())
+	}
+}
+
+func TestPrewarmDestroysSandboxThatFailsToStart(t *testing.T) {
+	fake := newFakeSandboxServer()
+	fake.failStartFor["sb-1"] = true
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	ctx := context.Background()
+
+	if err := client.Prewarm(ctx, "py-template", 1); err == nil {
+		t.Fatal("expected Prewarm to fail when Start fails, got nil")
+	}
+
+	fake.mu.Lock()
+	destroyCount := fake.destroys["sb-1"]
+	fake.mu.Unlock()
+	if destroyCount != 1 {
+		t.Errorf("got %d destroys for sb-1, want 1 (Prewarm must not leak a sandbox it failed to start)", destroyCount)
+	}
+
+	// The pool must not have parked the half-booted sandbox: a later
+	// Create from the same template should boot a fresh one, not hand out
+	// the orphan.
+	sb := client.Sandbox("")
+	if err := sb.Create(ctx, microsandbox.SandboxSpec{FromTemplate: "py-template"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if sb.ID() == "sb-1" {
+		t.Errorf("Create handed out the sandbox that failed to start in Prewarm")
+	}
+}
+
+func TestCheckoutDestroysOrphanWhenResumeFails(t *testing.T) {
+	fake := newFakeSandboxServer()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	ctx := context.Background()
+
+	if err := client.Prewarm(ctx, "py-template", 1); err != nil {
+		t.Fatalf("Prewarm: %v", err)
+	}
+
+	// The pooled sandbox is sb-1; fail its resume-on-checkout Start call.
+	fake.mu.Lock()
+	fake.failStartFor["sb-1"] = true
+	fake.mu.Unlock()
+
+	sb := client.Sandbox("")
+	err := sb.Create(ctx, microsandbox.SandboxSpec{FromTemplate: "py-template"})
+	if err == nil {
+		t.Fatal("expected Create to fail when resuming the pooled sandbox fails, got nil")
+	}
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if fake.destroys["sb-1"] != 1 {
+		t.Errorf("got %d destroys for sb-1, want 1 (a failed resume must not orphan the pooled sandbox)", fake.destroys["sb-1"])
+	}
+}
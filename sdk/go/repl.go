@@ -0,0 +1,61 @@
+package microsandbox
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReplSession is a persistent interpreter session inside a sandbox. Unlike
+// Run, which starts a fresh interpreter for each call, a ReplSession keeps
+// variables, imports, and other interpreter state across calls to Eval --
+// the same mental model as a notebook cell or an agent's scratch REPL.
+type ReplSession struct {
+	sandbox  *Sandbox
+	language Language
+	id       string
+}
+
+type createReplResponse struct {
+	ID string `json:"id"`
+}
+
+// Repl starts a new REPL session using the given language's interpreter.
+// The session persists until the sandbox is stopped or Close is called.
+func (s *Sandbox) Repl(ctx context.Context, language Language) (*ReplSession, error) {
+	var resp createReplResponse
+	req := struct {
+		Language Language `json:"language"`
+	}{Language: language}
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "POST", s.path("/repl"), req, &resp); err != nil {
+		return nil, fmt.Errorf("microsandbox: create %s repl: %w", language, err)
+	}
+	return &ReplSession{sandbox: s, language: language, id: resp.ID}, nil
+}
+
+type evalRequest struct {
+	Code string `json:"code"`
+}
+
+// Eval runs code in the session's interpreter, building on any state left
+// behind by previous Eval calls in the same session.
+func (r *ReplSession) Eval(ctx context.Context, code string) (*Execution, error) {
+	var resp runResponse
+	req := evalRequest{Code: code}
+	if err := r.sandbox.client.rpcRequest(r.sandbox.traceCtx(ctx), "POST", r.path("/eval"), req, &resp); err != nil {
+		return nil, fmt.Errorf("microsandbox: eval in %s repl: %w", r.language, err)
+	}
+	return &Execution{stdout: resp.Stdout, stderr: resp.Stderr, exitCode: resp.ExitCode}, nil
+}
+
+// Close tears down the REPL session, discarding its interpreter state.
+// It does not affect the parent sandbox.
+func (r *ReplSession) Close(ctx context.Context) error {
+	if err := r.sandbox.client.rpcRequest(r.sandbox.traceCtx(ctx), "DELETE", r.path(""), nil, nil); err != nil {
+		return fmt.Errorf("microsandbox: close %s repl: %w", r.language, err)
+	}
+	return nil
+}
+
+func (r *ReplSession) path(suffix string) string {
+	return r.sandbox.path("/repl/" + r.id + suffix)
+}
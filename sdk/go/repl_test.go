@@ -0,0 +1,84 @@
+package microsandbox_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestReplEvalCarriesStateAcrossCalls(t *testing.T) {
+	var evalCount int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes/sb-1/repl":
+			w.Write([]byte(`{"id":"repl-1"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes/sb-1/repl/repl-1/eval":
+			evalCount++
+			switch evalCount {
+			case 1:
+				w.Write([]byte(`{"stdout":"","stderr":"","exitCode":0}`))
+			case 2:
+				w.Write([]byte(`{"stdout":"1\n","stderr":"","exitCode":0}`))
+			}
+		default:
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+	ctx := context.Background()
+
+	repl, err := sb.Repl(ctx, microsandbox.LanguagePython)
+	if err != nil {
+		t.Fatalf("Repl: %v", err)
+	}
+
+	if _, err := repl.Eval(ctx, "x = 1"); err != nil {
+		t.Fatalf("Eval (set x): %v", err)
+	}
+	exec, err := repl.Eval(ctx, "print(x)")
+	if err != nil {
+		t.Fatalf("Eval (print x): %v", err)
+	}
+	if exec.Stdout() != "1\n" {
+		t.Errorf("got stdout %q, want %q (state from the prior Eval call)", exec.Stdout(), "1\n")
+	}
+	if evalCount != 2 {
+		t.Errorf("got %d eval calls, want 2", evalCount)
+	}
+}
+
+func TestReplClose(t *testing.T) {
+	var closed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/sandboxes/sb-1/repl":
+			w.Write([]byte(`{"id":"repl-1"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/sandboxes/sb-1/repl/repl-1":
+			closed = true
+		default:
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+	ctx := context.Background()
+
+	repl, err := sb.Repl(ctx, microsandbox.LanguageNode)
+	if err != nil {
+		t.Fatalf("Repl: %v", err)
+	}
+	if err := repl.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if !closed {
+		t.Error("Close did not issue the DELETE request for the repl session")
+	}
+}
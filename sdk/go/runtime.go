@@ -0,0 +1,38 @@
+package microsandbox
+
+import "context"
+
+// Runtime is a language-specific handle onto a sandbox, offered as a
+// convenience over Run/RunStream/Repl so callers don't have to repeat a
+// Language argument at every call site.
+type Runtime struct {
+	sandbox  *Sandbox
+	language Language
+}
+
+// Python returns a handle for running Python code in the sandbox.
+func (s *Sandbox) Python() *Runtime {
+	return &Runtime{sandbox: s, language: LanguagePython}
+}
+
+// Node returns a handle for running Node.js code in the sandbox.
+func (s *Sandbox) Node() *Runtime {
+	return &Runtime{sandbox: s, language: LanguageNode}
+}
+
+// Run executes code with this runtime's interpreter and waits for it to
+// complete. See Sandbox.Run.
+func (r *Runtime) Run(ctx context.Context, code string) (*Execution, error) {
+	return r.sandbox.Run(ctx, r.language, code)
+}
+
+// RunStream is the streaming variant of Run. See Sandbox.RunStream.
+func (r *Runtime) RunStream(ctx context.Context, code string) (*StreamedExecution, error) {
+	return r.sandbox.RunStream(ctx, r.language, code)
+}
+
+// Repl starts a persistent REPL session using this runtime's interpreter.
+// See Sandbox.Repl.
+func (r *Runtime) Repl(ctx context.Context) (*ReplSession, error) {
+	return r.sandbox.Repl(ctx, r.language)
+}
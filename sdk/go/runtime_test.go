@@ -0,0 +1,40 @@
+package microsandbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestRuntimePythonAndNodeRunWithTheirLanguage(t *testing.T) {
+	var gotLanguages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Language string `json:"language"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		gotLanguages = append(gotLanguages, req.Language)
+		json.NewEncoder(w).Encode(map[string]any{"stdout": "ok", "stderr": "", "exitCode": 0})
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+	ctx := context.Background()
+
+	if _, err := sb.Python().Run(ctx, "print(1)"); err != nil {
+		t.Fatalf("Python().Run: %v", err)
+	}
+	if _, err := sb.Node().Run(ctx, "console.log(1)"); err != nil {
+		t.Fatalf("Node().Run: %v", err)
+	}
+
+	want := []string{string(microsandbox.LanguagePython), string(microsandbox.LanguageNode)}
+	if len(gotLanguages) != len(want) || gotLanguages[0] != want[0] || gotLanguages[1] != want[1] {
+		t.Errorf("got languages %v, want %v", gotLanguages, want)
+	}
+}
@@ -0,0 +1,212 @@
+package microsandbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Status is the lifecycle state of a Sandbox as reported by the server.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusStarting  Status = "starting"
+	StatusRunning   Status = "running"
+	StatusStopped   Status = "stopped"
+	StatusDestroyed Status = "destroyed"
+	StatusError     Status = "error"
+)
+
+// NetworkPolicy controls what a sandbox is allowed to reach over the
+// network.
+type NetworkPolicy string
+
+const (
+	// NetworkNone disables all network access from the sandbox.
+	NetworkNone NetworkPolicy = "none"
+	// NetworkEgressOnly allows outbound connections but no inbound ones.
+	NetworkEgressOnly NetworkPolicy = "egress-only"
+	// NetworkFull allows unrestricted network access.
+	NetworkFull NetworkPolicy = "full"
+)
+
+// Mount describes a host-to-guest (or volume-to-guest) path mapping.
+type Mount struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+}
+
+// SandboxSpec describes the sandbox to create.
+type SandboxSpec struct {
+	// Image is the OCI image or microVM image to boot the sandbox from.
+	Image string `json:"image"`
+
+	// FromTemplate, if set, forks the sandbox from a pre-warmed template
+	// instead of booting Image from scratch. See Client.Templates.
+	FromTemplate string `json:"fromTemplate,omitempty"`
+
+	// CPUs is the number of vCPUs allotted to the sandbox.
+	CPUs float64 `json:"cpus,omitempty"`
+	// MemoryMB is the memory limit in megabytes.
+	MemoryMB int `json:"memoryMb,omitempty"`
+
+	// Env is the set of environment variables exposed to processes running
+	// in the sandbox.
+	Env map[string]string `json:"env,omitempty"`
+
+	// Mounts lists filesystem mounts made available inside the sandbox.
+	Mounts []Mount `json:"mounts,omitempty"`
+
+	// Network controls the sandbox's network policy. Defaults to
+	// NetworkEgressOnly on the server if left empty.
+	Network NetworkPolicy `json:"network,omitempty"`
+}
+
+// Sandbox is a handle to a single Microsandbox sandbox instance.
+type Sandbox struct {
+	client *Client
+	id     string
+
+	// spec is populated once Create has been called.
+	spec *SandboxSpec
+}
+
+// ID returns the sandbox's server-assigned identifier. For a handle
+// obtained with Client.Sandbox(""), it is empty until Create has completed
+// successfully.
+func (s *Sandbox) ID() string {
+	return s.id
+}
+
+type createSandboxResponse struct {
+	ID string `json:"id"`
+}
+
+// Create provisions the sandbox on the server according to spec. It does
+// not wait for the sandbox to be ready to accept work; call Start followed
+// by WaitReady for that.
+//
+// If spec.FromTemplate names a template with a Client.Prewarm pool, Create
+// hands out and resumes one of its paused sandboxes instead of booting a
+// fresh one, for a sub-second start.
+func (s *Sandbox) Create(ctx context.Context, spec SandboxSpec) error {
+	ctx = context.WithValue(ctx, ctxKeySandboxImage, spec.Image)
+
+	if spec.FromTemplate != "" {
+		if id, ok, err := s.client.checkoutPrewarmed(ctx, spec.FromTemplate); err != nil {
+			return err
+		} else if ok {
+			s.id = id
+			s.spec = &spec
+			// Prewarm decremented this sandbox out of in-flight when it was
+			// parked in the pool; re-add it now that it's handed to a caller.
+			s.client.instruments.inFlightSandboxes.Add(ctx, 1)
+			return nil
+		}
+	}
+
+	return s.createRemote(ctx, spec)
+}
+
+// createRemote always boots a fresh sandbox from the server, bypassing any
+// prewarm pool. Prewarm uses this directly so that filling a template's
+// pool doesn't drain from the very pool it's trying to fill.
+func (s *Sandbox) createRemote(ctx context.Context, spec SandboxSpec) error {
+	var resp createSandboxResponse
+	if err := s.client.rpcRequest(ctx, "POST", "/v1/sandboxes", spec, &resp); err != nil {
+		return fmt.Errorf("microsandbox: create sandbox: %w", err)
+	}
+	s.id = resp.ID
+	s.spec = &spec
+	s.client.instruments.inFlightSandboxes.Add(ctx, 1)
+	return nil
+}
+
+// Start boots a previously created (or stopped) sandbox.
+func (s *Sandbox) Start(ctx context.Context) error {
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "POST", s.path("/start"), nil, nil); err != nil {
+		return fmt.Errorf("microsandbox: start sandbox %s: %w", s.id, err)
+	}
+	return nil
+}
+
+// Stop gracefully stops a running sandbox. The sandbox's filesystem and
+// state are preserved and it can be Started again.
+func (s *Sandbox) Stop(ctx context.Context) error {
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "POST", s.path("/stop"), nil, nil); err != nil {
+		return fmt.Errorf("microsandbox: stop sandbox %s: %w", s.id, err)
+	}
+	return nil
+}
+
+// Destroy permanently tears down the sandbox and releases its resources.
+// After Destroy, the sandbox id is no longer valid.
+func (s *Sandbox) Destroy(ctx context.Context) error {
+	if err := s.destroyRemote(ctx); err != nil {
+		return err
+	}
+	s.client.instruments.inFlightSandboxes.Add(ctx, -1)
+	return nil
+}
+
+// destroyRemote issues the DELETE RPC that tears down the sandbox on the
+// server, without adjusting the in-flight sandbox counter. Use this
+// instead of Destroy for a sandbox that Create never counted as in-flight
+// in the first place (e.g. one still parked in a prewarm pool).
+func (s *Sandbox) destroyRemote(ctx context.Context) error {
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "DELETE", s.path(""), nil, nil); err != nil {
+		return fmt.Errorf("microsandbox: destroy sandbox %s: %w", s.id, err)
+	}
+	return nil
+}
+
+// StatusInfo describes a sandbox's current lifecycle state.
+type StatusInfo struct {
+	Status Status `json:"status"`
+	// Reason carries additional detail when Status is StatusError.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Status fetches the sandbox's current lifecycle state from the server.
+func (s *Sandbox) Status(ctx context.Context) (*StatusInfo, error) {
+	var info StatusInfo
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "GET", s.path("/status"), nil, &info); err != nil {
+		return nil, fmt.Errorf("microsandbox: status sandbox %s: %w", s.id, err)
+	}
+	return &info, nil
+}
+
+// WaitReady polls Status until the sandbox reaches StatusRunning (returning
+// nil), reaches StatusError, reaches StatusDestroyed (returning an error
+// wrapping ErrSandboxNotFound), a Status call fails, or ctx is done,
+// whichever comes first.
+func (s *Sandbox) WaitReady(ctx context.Context) error {
+	const pollInterval = 250 * time.Millisecond
+
+	for {
+		info, err := s.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		switch info.Status {
+		case StatusRunning:
+			return nil
+		case StatusError:
+			return fmt.Errorf("microsandbox: sandbox %s entered error state: %s", s.id, info.Reason)
+		case StatusDestroyed:
+			return fmt.Errorf("%w: %s", ErrSandboxNotFound, s.id)
+		}
+
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// path builds the server path for a sub-resource of this sandbox.
+func (s *Sandbox) path(suffix string) string {
+	return "/v1/sandboxes/" + s.id + suffix
+}
@@ -0,0 +1,85 @@
+package microsandbox_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"running"}`))
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	info, err := sb.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	if info.Status != microsandbox.StatusRunning {
+		t.Errorf("got status %q, want %q", info.Status, microsandbox.StatusRunning)
+	}
+}
+
+func TestWaitReadyPollsUntilRunning(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 2 {
+			w.Write([]byte(`{"status":"starting"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"running"}`))
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := sb.WaitReady(ctx); err != nil {
+		t.Fatalf("WaitReady: %v", err)
+	}
+	if calls < 2 {
+		t.Errorf("got %d Status calls, want at least 2 (one starting, one running)", calls)
+	}
+}
+
+func TestWaitReadyReturnsErrorOnErrorState(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"error","reason":"boot failed"}`))
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	err := sb.WaitReady(context.Background())
+	if err == nil {
+		t.Fatal("expected an error when the sandbox enters StatusError, got nil")
+	}
+}
+
+func TestWaitReadyReturnsSandboxNotFoundOnDestroyed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"destroyed"}`))
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	err := sb.WaitReady(context.Background())
+	if !errors.Is(err, microsandbox.ErrSandboxNotFound) {
+		t.Errorf("got error %v, want one wrapping ErrSandboxNotFound", err)
+	}
+}
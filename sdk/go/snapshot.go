@@ -0,0 +1,32 @@
+package microsandbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Snapshot is a point-in-time capture of a sandbox's memory and disk
+// state that a new sandbox can later be forked from via
+// SandboxSpec.FromTemplate, skipping the normal boot sequence.
+type Snapshot struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	SandboxID string    `json:"sandboxId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type createSnapshotRequest struct {
+	Name string `json:"name"`
+}
+
+// Snapshot captures the sandbox's current memory and disk state under
+// name, so it can later be used as a template source.
+func (s *Sandbox) Snapshot(ctx context.Context, name string) (*Snapshot, error) {
+	var snap Snapshot
+	req := createSnapshotRequest{Name: name}
+	if err := s.client.rpcRequest(s.traceCtx(ctx), "POST", s.path("/snapshot"), req, &snap); err != nil {
+		return nil, fmt.Errorf("microsandbox: snapshot sandbox %s: %w", s.id, err)
+	}
+	return &snap, nil
+}
@@ -0,0 +1,35 @@
+package microsandbox_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+func TestSnapshotRoundTrips(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/sandboxes/sb-1/snapshot" {
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"snap-1","name":"nightly","sandboxId":"sb-1","createdAt":"2026-01-02T03:04:05Z"}`))
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	sb := client.Sandbox("sb-1")
+
+	snap, err := sb.Snapshot(context.Background(), "nightly")
+	if err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+	if snap.ID != "snap-1" || snap.Name != "nightly" || snap.SandboxID != "sb-1" {
+		t.Errorf("got %+v, want id=snap-1 name=nightly sandboxId=sb-1", snap)
+	}
+	if snap.CreatedAt.IsZero() {
+		t.Error("CreatedAt was not decoded")
+	}
+}
@@ -0,0 +1,160 @@
+package microsandbox
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/yourusername/monocore/sdk/go"
+
+// instruments bundles the metrics the Client emits for every RPC.
+type instruments struct {
+	requestDuration   metric.Float64Histogram
+	inFlightSandboxes metric.Int64UpDownCounter
+	bytesTransferred  metric.Int64Counter
+}
+
+// WithLogger sets the structured logger the Client uses for diagnostic
+// output. Defaults to slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithTracerProvider sets the OpenTelemetry TracerProvider used to open a
+// span for each RPC. Defaults to the global provider set via
+// otel.SetTracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Client) {
+		c.tracer = tp.Tracer(instrumentationName)
+	}
+}
+
+// WithMeterProvider sets the OpenTelemetry MeterProvider used to record
+// request latency, in-flight sandbox counts, and file-stream byte counts.
+// Defaults to the global provider set via otel.SetMeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *Client) {
+		c.instruments = newInstruments(mp.Meter(instrumentationName))
+	}
+}
+
+func newInstruments(meter metric.Meter) *instruments {
+	requestDuration, _ := meter.Float64Histogram(
+		"microsandbox.client.request.duration",
+		metric.WithDescription("Duration of Microsandbox API requests"),
+		metric.WithUnit("s"),
+	)
+	inFlightSandboxes, _ := meter.Int64UpDownCounter(
+		"microsandbox.client.sandboxes.in_flight",
+		metric.WithDescription("Number of sandboxes created or checked out of a prewarm pool and not yet destroyed; sandboxes parked in a prewarm pool are not counted"),
+	)
+	bytesTransferred, _ := meter.Int64Counter(
+		"microsandbox.client.fs.bytes_transferred",
+		metric.WithDescription("Bytes transferred over filesystem upload/download streams"),
+		metric.WithUnit("By"),
+	)
+	return &instruments{
+		requestDuration:   requestDuration,
+		inFlightSandboxes: inFlightSandboxes,
+		bytesTransferred:  bytesTransferred,
+	}
+}
+
+// ctxKey namespaces context values set by this package.
+type ctxKey int
+
+const (
+	ctxKeySandboxID ctxKey = iota
+	ctxKeySandboxImage
+)
+
+// traceCtx annotates ctx with this sandbox's id and image so that any RPC
+// issued with the returned context carries sandbox.id/sandbox.image span
+// attributes, even for calls made through a sub-namespace (CommandNamespace,
+// FSNamespace, ReplSession, Runtime) that don't otherwise see the spec.
+func (s *Sandbox) traceCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, ctxKeySandboxID, s.id)
+	if s.spec != nil {
+		ctx = context.WithValue(ctx, ctxKeySandboxImage, s.spec.Image)
+	}
+	return ctx
+}
+
+// routeIDSegment matches the opaque-id path segment following the
+// sandbox/repl/template resource collections this package routes under,
+// e.g. "/sandboxes/abc123" or "/repl/xyz". It's used to collapse a request
+// path down to its route template before the path is used as a span name
+// or metric attribute -- otherwise every sandbox/repl/template id would
+// mint its own time series. "command" is deliberately excluded here: see
+// commandIDSegment.
+var routeIDSegment = regexp.MustCompile(`/(sandboxes|repl|templates)/[^/?]+`)
+
+// commandIDSegment matches the opaque process-id segment in a command
+// sub-resource path, e.g. "/command/p_1/wait" -> "/command/{id}/wait". It
+// does not match the literal "/command/run" or "/command/start" routes,
+// which have no id segment at all -- folding those into routeIDSegment's
+// general pattern would collapse Run and Start, with very different
+// latency profiles, into the same route template.
+var commandIDSegment = regexp.MustCompile(`/command/[^/?]+/(wait|signal|kill)`)
+
+// routeTemplate strips the query string and replaces opaque ids in path
+// with a placeholder, so the result is safe to use as a span name or a
+// low-cardinality metric label, e.g.
+// "/v1/sandboxes/sb_123/repl/r_1/eval?x=1" -> "/v1/sandboxes/{id}/repl/{id}/eval".
+func routeTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	path = commandIDSegment.ReplaceAllString(path, "/command/{id}/$1")
+	return routeIDSegment.ReplaceAllString(path, "/$1/{id}")
+}
+
+// span starts a span for an RPC to path, named after its route template
+// and tagged with rpc.method, rpc.route, and any sandbox.id/sandbox.image
+// carried on ctx.
+func (c *Client) span(ctx context.Context, method, path string) (context.Context, trace.Span) {
+	route := routeTemplate(path)
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.route", route),
+	}
+	if id, ok := ctx.Value(ctxKeySandboxID).(string); ok && id != "" {
+		attrs = append(attrs, attribute.String("sandbox.id", id))
+	}
+	if image, ok := ctx.Value(ctxKeySandboxImage).(string); ok && image != "" {
+		attrs = append(attrs, attribute.String("sandbox.image", image))
+	}
+	return c.tracer.Start(ctx, route, trace.WithAttributes(attrs...))
+}
+
+// recordRequestDuration emits the microsandbox.client.request.duration
+// histogram for one completed RPC, labeled with the low-cardinality route
+// template rather than the raw path (which embeds sandbox/process ids).
+func (c *Client) recordRequestDuration(ctx context.Context, method, path string, since time.Time, err error) {
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.route", routeTemplate(path)),
+	}
+	if err != nil {
+		attrs = append(attrs, attribute.Bool("error", true))
+	}
+	c.instruments.requestDuration.Record(ctx, time.Since(since).Seconds(), metric.WithAttributes(attrs...))
+}
+
+func defaultTracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer(instrumentationName)
+}
+
+func defaultInstruments() *instruments {
+	return newInstruments(otel.GetMeterProvider().Meter(instrumentationName))
+}
@@ -0,0 +1,26 @@
+package microsandbox
+
+import "testing"
+
+func TestRouteTemplate(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/v1/sandboxes/sb_123/command/run", "/v1/sandboxes/{id}/command/run"},
+		{"/v1/sandboxes/sb_123/command/start", "/v1/sandboxes/{id}/command/start"},
+		{"/v1/sandboxes/sb_123/command/p_1/wait", "/v1/sandboxes/{id}/command/{id}/wait"},
+		{"/v1/sandboxes/sb_123/command/p_1/signal", "/v1/sandboxes/{id}/command/{id}/signal"},
+		{"/v1/sandboxes/sb_123/command/p_1/kill", "/v1/sandboxes/{id}/command/{id}/kill"},
+		{"/v1/sandboxes/sb_123/repl/r_1/eval?x=1", "/v1/sandboxes/{id}/repl/{id}/eval"},
+		{"/v1/templates/py-template", "/v1/templates/{id}"},
+	}
+	for _, tt := range tests {
+		if got := routeTemplate(tt.path); got != tt.want {
+			t.Errorf("routeTemplate(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+	if run, start := routeTemplate("/v1/sandboxes/sb_1/command/run"), routeTemplate("/v1/sandboxes/sb_2/command/start"); run == start {
+		t.Errorf("Command.Run and Command.Start collapsed to the same route template %q", run)
+	}
+}
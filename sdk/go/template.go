@@ -0,0 +1,122 @@
+package microsandbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Template is a pre-warmed memory+disk image that a sandbox can be forked
+// from via SandboxSpec.FromTemplate for a sub-second cold start, instead
+// of booting from its base Image.
+type Template struct {
+	Name string `json:"name"`
+	// Packages lists the packages pre-installed in the template image.
+	Packages []string `json:"packages,omitempty"`
+	// Files lists paths pre-seeded into the template's filesystem.
+	Files []string `json:"files,omitempty"`
+	// BootTime is how long the template took to reach ready state when it
+	// was built, reported for operators tuning prewarm pool sizes. The
+	// server reports this in milliseconds; see UnmarshalJSON.
+	BootTime time.Duration `json:"-"`
+	// Ready reports whether the template has finished building and can be
+	// used with SandboxSpec.FromTemplate.
+	Ready     bool      `json:"ready"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// templateWire is the wire shape of Template. BootTime travels as
+// milliseconds on the wire but is exposed to callers as a time.Duration.
+type templateWire struct {
+	Name       string    `json:"name"`
+	Packages   []string  `json:"packages,omitempty"`
+	Files      []string  `json:"files,omitempty"`
+	BootTimeMs int64     `json:"bootTimeMs"`
+	Ready      bool      `json:"ready"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// UnmarshalJSON converts the server's millisecond bootTimeMs field into a
+// time.Duration.
+func (t *Template) UnmarshalJSON(data []byte) error {
+	var wire templateWire
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	*t = Template{
+		Name:      wire.Name,
+		Packages:  wire.Packages,
+		Files:     wire.Files,
+		BootTime:  time.Duration(wire.BootTimeMs) * time.Millisecond,
+		Ready:     wire.Ready,
+		CreatedAt: wire.CreatedAt,
+	}
+	return nil
+}
+
+// MarshalJSON is the inverse of UnmarshalJSON: it encodes BootTime as the
+// millisecond bootTimeMs field.
+func (t Template) MarshalJSON() ([]byte, error) {
+	return json.Marshal(templateWire{
+		Name:       t.Name,
+		Packages:   t.Packages,
+		Files:      t.Files,
+		BootTimeMs: t.BootTime.Milliseconds(),
+		Ready:      t.Ready,
+		CreatedAt:  t.CreatedAt,
+	})
+}
+
+// TemplatesNamespace manages templates available to the account.
+// Obtain one via Client.Templates.
+type TemplatesNamespace struct {
+	client *Client
+}
+
+// Templates returns the namespace for managing templates.
+func (c *Client) Templates() *TemplatesNamespace {
+	return &TemplatesNamespace{client: c}
+}
+
+// CreateTemplateSpec describes a template to build.
+type CreateTemplateSpec struct {
+	Name string `json:"name"`
+	// FromSnapshot, if set, builds the template from an existing snapshot
+	// rather than an Image boot.
+	FromSnapshot string `json:"fromSnapshot,omitempty"`
+	// Image is the base image to boot and seed the template from, used
+	// when FromSnapshot is empty.
+	Image string `json:"image,omitempty"`
+	// Packages are installed into the template image before it is sealed.
+	Packages []string `json:"packages,omitempty"`
+}
+
+// Create builds a new template. Building happens asynchronously on the
+// server; poll List until Template.Ready is true before using it
+// with SandboxSpec.FromTemplate.
+func (t *TemplatesNamespace) Create(ctx context.Context, spec CreateTemplateSpec) (*Template, error) {
+	var tmpl Template
+	if err := t.client.rpcRequest(ctx, "POST", "/v1/templates", spec, &tmpl); err != nil {
+		return nil, fmt.Errorf("microsandbox: create template %s: %w", spec.Name, err)
+	}
+	return &tmpl, nil
+}
+
+// List returns every template available to the account.
+func (t *TemplatesNamespace) List(ctx context.Context) ([]Template, error) {
+	var templates []Template
+	if err := t.client.rpcRequest(ctx, "GET", "/v1/templates", nil, &templates); err != nil {
+		return nil, fmt.Errorf("microsandbox: list templates: %w", err)
+	}
+	return templates, nil
+}
+
+// Delete removes a template by name. It does not affect sandboxes already
+// forked from it.
+func (t *TemplatesNamespace) Delete(ctx context.Context, name string) error {
+	if err := t.client.rpcRequest(ctx, "DELETE", "/v1/templates/"+name, nil, nil); err != nil {
+		return fmt.Errorf("microsandbox: delete template %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package microsandbox_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	microsandbox "github.com/yourusername/monocore/sdk/go"
+)
+
+// TestTemplateBootTimeRoundTrips checks that Templates().Create and List
+// responses decode, with bootTimeMs converted into BootTime by
+// UnmarshalJSON. Only the decode direction is exercised; MarshalJSON is not.
+func TestTemplateBootTimeRoundTrips(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/templates":
+			w.Write([]byte(`{"name":"py-template","ready":false,"bootTimeMs":0,"createdAt":"2026-01-02T03:04:05Z"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/templates":
+			w.Write([]byte(`[{"name":"py-template","packages":["numpy"],"ready":true,"bootTimeMs":1500,"createdAt":"2026-01-02T03:04:05Z"}]`))
+		default:
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	ctx := context.Background()
+
+	created, err := client.Templates().Create(ctx, microsandbox.CreateTemplateSpec{Name: "py-template", Image: "python:3.12"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if created.Ready {
+		t.Error("freshly created template should not yet be Ready")
+	}
+
+	templates, err := client.Templates().List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(templates) != 1 {
+		t.Fatalf("got %d templates, want 1", len(templates))
+	}
+	got := templates[0]
+	if !got.Ready {
+		t.Error("got Ready = false, want true")
+	}
+	if got.BootTime != 1500*time.Millisecond {
+		t.Errorf("got BootTime %v, want 1.5s (decoded from bootTimeMs=1500)", got.BootTime)
+	}
+	if len(got.Packages) != 1 || got.Packages[0] != "numpy" {
+		t.Errorf("got Packages %v, want [numpy]", got.Packages)
+	}
+}
+
+func TestTemplatesDelete(t *testing.T) {
+	var gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if r.Method != http.MethodDelete {
+			http.Error(w, "unexpected method "+r.Method, http.StatusMethodNotAllowed)
+		}
+	}))
+	defer srv.Close()
+
+	client := microsandbox.NewClient(srv.URL, "test-key")
+	if err := client.Templates().Delete(context.Background(), "py-template"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if gotPath != "/v1/templates/py-template" {
+		t.Errorf("got path %q, want /v1/templates/py-template", gotPath)
+	}
+}